@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
@@ -41,13 +42,15 @@ var getGvk = apiutil.GVKForObject
 
 // Builder builds a Controller.
 type Builder struct {
-	apiType        runtime.Object
-	mgr            manager.Manager
-	predicates     []predicate.Predicate
-	managedObjects []runtime.Object
-	watchRequest   []watchRequest
-	config         *rest.Config
-	ctrl           controller.Controller
+	forInput     ForInput
+	ownsInput    []OwnsInput
+	watchesInput []WatchesInput
+	mgr          manager.Manager
+	predicates   []predicate.Predicate
+	config       *rest.Config
+	ctrlOptions  controller.Options
+	name         string
+	ctrl         controller.Controller
 }
 
 // SimpleController returns a new Builder.
@@ -74,34 +77,82 @@ func (blder *Builder) ForType(apiType runtime.Object) *Builder {
 	return blder.For(apiType)
 }
 
+// ForInput represents the information set by the For method.
+type ForInput struct {
+	object           runtime.Object
+	predicates       []predicate.Predicate
+	objectProjection objectProjection
+}
+
+// ForOption is some configuration that modifies options for a For request.
+type ForOption interface {
+	// ApplyToFor applies this configuration to the given ForInput options.
+	ApplyToFor(*ForInput)
+}
+
 // For defines the type of Object being *reconciled*, and configures the ControllerManagedBy to respond to create / delete /
 // update events by *reconciling the object*.
 // This is the equivalent of calling
 // Watches(&source.Kind{Type: apiType}, &handler.EnqueueRequestForObject{})
 // If the passed in object has implemented the admission.Defaulter interface, a MutatingWebhook will be wired for this type.
 // If the passed in object has implemented the admission.Validator interface, a ValidatingWebhook will be wired for this type.
-func (blder *Builder) For(apiType runtime.Object) *Builder {
-	blder.apiType = apiType
+func (blder *Builder) For(apiType runtime.Object, opts ...ForOption) *Builder {
+	blder.forInput = ForInput{object: apiType}
+	for _, opt := range opts {
+		opt.ApplyToFor(&blder.forInput)
+	}
 	return blder
 }
 
+// OwnsInput represents the information set by the Owns method.
+type OwnsInput struct {
+	object           runtime.Object
+	predicates       []predicate.Predicate
+	objectProjection objectProjection
+	matchEveryOwner  bool
+	isController     *bool
+}
+
+// OwnsOption is some configuration that modifies options for an Owns request.
+type OwnsOption interface {
+	// ApplyToOwns applies this configuration to the given OwnsInput options.
+	ApplyToOwns(*OwnsInput)
+}
+
 // Owns defines types of Objects being *generated* by the ControllerManagedBy, and configures the ControllerManagedBy to respond to
 // create / delete / update events by *reconciling the owner object*.  This is the equivalent of calling
 // Watches(&handler.EnqueueRequestForOwner{&source.Kind{Type: <ForType-apiType>}, &handler.EnqueueRequestForOwner{OwnerType: apiType, IsController: true})
-func (blder *Builder) Owns(apiType runtime.Object) *Builder {
-	blder.managedObjects = append(blder.managedObjects, apiType)
+func (blder *Builder) Owns(apiType runtime.Object, opts ...OwnsOption) *Builder {
+	input := OwnsInput{object: apiType}
+	for _, opt := range opts {
+		opt.ApplyToOwns(&input)
+	}
+	blder.ownsInput = append(blder.ownsInput, input)
 	return blder
 }
 
-type watchRequest struct {
-	src          source.Source
-	eventhandler handler.EventHandler
+// WatchesInput represents the information set by Watches method.
+type WatchesInput struct {
+	src              source.Source
+	eventhandler     handler.EventHandler
+	predicates       []predicate.Predicate
+	objectProjection objectProjection
+}
+
+// WatchesOption is some configuration that modifies options for a Watches request.
+type WatchesOption interface {
+	// ApplyToWatches applies this configuration to the given WatchesInput options.
+	ApplyToWatches(*WatchesInput)
 }
 
 // Watches exposes the lower-level ControllerManagedBy Watches functions through the builder.  Consider using
 // Owns or For instead of Watches directly.
-func (blder *Builder) Watches(src source.Source, eventhandler handler.EventHandler) *Builder {
-	blder.watchRequest = append(blder.watchRequest, watchRequest{src: src, eventhandler: eventhandler})
+func (blder *Builder) Watches(src source.Source, eventhandler handler.EventHandler, opts ...WatchesOption) *Builder {
+	input := WatchesInput{src: src, eventhandler: eventhandler}
+	for _, opt := range opts {
+		opt.ApplyToWatches(&input)
+	}
+	blder.watchesInput = append(blder.watchesInput, input)
 	return blder
 }
 
@@ -129,6 +180,25 @@ func (blder *Builder) WithEventFilter(p predicate.Predicate) *Builder {
 	return blder
 }
 
+// WithOptions overrides the controller.Options used to build the controller, e.g. to set
+// MaxConcurrentReconciles, a RateLimiter, or RecoverPanic. The Reconciler field is always
+// taken from the argument passed to Complete/Build, so it need not be set here.
+func (blder *Builder) WithOptions(options controller.Options) *Builder {
+	blder.ctrlOptions = options
+	return blder
+}
+
+// Named sets the name of the controller to the given name.  The name shows up in logs and
+// in metrics used to monitor the controller.  If not set, the name is defaulted to the
+// lowercase version of the For type's Kind followed by "-application".
+//
+// It is required to set this name when building more than one controller that reconciles
+// the same kind in the same Manager, since the derived name would otherwise collide.
+func (blder *Builder) Named(name string) *Builder {
+	blder.name = name
+	return blder
+}
+
 // Complete builds the Application ControllerManagedBy.
 func (blder *Builder) Complete(r reconcile.Reconciler) error {
 	_, err := blder.Build(r)
@@ -171,33 +241,186 @@ func (blder *Builder) Build(r reconcile.Reconciler) (manager.Manager, error) {
 	return blder.mgr, nil
 }
 
+// objectProjection is the specification of the projection mode for an object
+// passed to For, Owns or Watches.
+type objectProjection int
+
+const (
+	// projectAsNormal doesn't change the object from the one passed in.
+	projectAsNormal objectProjection = iota
+	// projectAsMetadata strips the object down to its metav1.PartialObjectMetadata
+	// form, so that only the object's metadata (including owner references) is
+	// cached and watched, not its spec/status.
+	projectAsMetadata
+)
+
+// OnlyMetadata tells the provided For, Owns or Watches that this resource should
+// be watched and cached in metadata-only form, instead of the full object. This
+// is useful for controllers that only need to react to metadata (e.g. to find
+// owner references) and would otherwise pay the memory/CPU cost of caching full
+// objects for resources with a large number of instances (Pods, Secrets, etc).
+var OnlyMetadata = onlyMetadata{}
+
+type onlyMetadata struct{}
+
+func (onlyMetadata) ApplyToFor(opts *ForInput) {
+	opts.objectProjection = projectAsMetadata
+}
+
+func (onlyMetadata) ApplyToOwns(opts *OwnsInput) {
+	opts.objectProjection = projectAsMetadata
+}
+
+func (onlyMetadata) ApplyToWatches(opts *WatchesInput) {
+	opts.objectProjection = projectAsMetadata
+}
+
+var _ ForOption = OnlyMetadata
+var _ OwnsOption = OnlyMetadata
+var _ WatchesOption = OnlyMetadata
+
+// WithPredicates sets the given predicates list, scoped to this one For, Owns or Watches
+// source, in addition to the predicates set on the whole Builder via WithEventFilter.
+func WithPredicates(predicates ...predicate.Predicate) Predicates {
+	return Predicates{
+		predicates: predicates,
+	}
+}
+
+// Predicates filters the events before enqueuing the reconcile.Request for a single
+// For, Owns or Watches source.
+type Predicates struct {
+	predicates []predicate.Predicate
+}
+
+func (w Predicates) ApplyToFor(opts *ForInput) {
+	opts.predicates = w.predicates
+}
+
+func (w Predicates) ApplyToOwns(opts *OwnsInput) {
+	opts.predicates = w.predicates
+}
+
+func (w Predicates) ApplyToWatches(opts *WatchesInput) {
+	opts.predicates = w.predicates
+}
+
+var _ ForOption = Predicates{}
+var _ OwnsOption = Predicates{}
+var _ WatchesOption = Predicates{}
+
+// MatchEveryOwner tells the Owns that, rather than enqueueing only the object's
+// single controller owner reference (as returned by metav1.GetControllerOf),
+// every owner reference matching the For type should be reconciled. This is
+// needed when a managed object can carry more than one owner reference of the
+// same kind and all of them are expected to see the event, not just whichever
+// one happens to be the controller ref.
+var MatchEveryOwner OwnsOption = matchEveryOwner{}
+
+type matchEveryOwner struct{}
+
+func (matchEveryOwner) ApplyToOwns(opts *OwnsInput) {
+	opts.matchEveryOwner = true
+}
+
+// WithController tells the Owns whether the OwnerType should be treated as a
+// controller owner reference (IsController: true, the default applied by
+// Owns) or as a plain, non-controller owner reference (IsController: false).
+// Use this for owner-scoped watches where the managed object isn't actually
+// controller-owned by the reconciled type. MatchEveryOwner works by setting
+// IsController: false, and an explicit WithController takes precedence over
+// it, so WithController(true) combined with MatchEveryOwner enqueues only the
+// controller owner reference.
+func WithController(isController bool) OwnsOption {
+	return withController{isController: isController}
+}
+
+type withController struct {
+	isController bool
+}
+
+func (w withController) ApplyToOwns(opts *OwnsInput) {
+	opts.isController = &w.isController
+}
+
+// resolveIsController returns the IsController value doWatch should pass to
+// handler.EnqueueRequestForOwner for this Owns: an explicit WithController
+// wins, otherwise MatchEveryOwner implies IsController: false and the
+// unmodified default is IsController: true.
+func (own OwnsInput) resolveIsController() bool {
+	if own.isController != nil {
+		return *own.isController
+	}
+	return !own.matchEveryOwner
+}
+
+// project returns the object that should actually be watched, applying the
+// requested projection (e.g. turning it into a metav1.PartialObjectMetadata
+// resolved against the manager's scheme for metadata-only watches).
+func (blder *Builder) project(obj runtime.Object, proj objectProjection) (runtime.Object, error) {
+	switch proj {
+	case projectAsNormal:
+		return obj, nil
+	case projectAsMetadata:
+		gvk, err := getGvk(obj, blder.mgr.GetScheme())
+		if err != nil {
+			return nil, err
+		}
+		pom := &metav1.PartialObjectMetadata{}
+		pom.SetGroupVersionKind(gvk)
+		return pom, nil
+	default:
+		return nil, fmt.Errorf("unknown object projection %v", proj)
+	}
+}
+
 func (blder *Builder) doWatch() error {
 	// Reconcile type
-	src := &source.Kind{Type: blder.apiType}
-	hdler := &handler.EnqueueRequestForObject{}
-	err := blder.ctrl.Watch(src, hdler, blder.predicates...)
+	typeForSrc, err := blder.project(blder.forInput.object, blder.forInput.objectProjection)
 	if err != nil {
 		return err
 	}
+	src := &source.Kind{Type: typeForSrc}
+	hdler := &handler.EnqueueRequestForObject{}
+	allPredicates := append([]predicate.Predicate(nil), blder.predicates...)
+	allPredicates = append(allPredicates, blder.forInput.predicates...)
+	if err := blder.ctrl.Watch(src, hdler, allPredicates...); err != nil {
+		return err
+	}
 
 	// Watches the managed types
-	for _, obj := range blder.managedObjects {
-		src := &source.Kind{Type: obj}
+	for _, own := range blder.ownsInput {
+		typeForSrc, err := blder.project(own.object, own.objectProjection)
+		if err != nil {
+			return err
+		}
+		src := &source.Kind{Type: typeForSrc}
 		hdler := &handler.EnqueueRequestForOwner{
-			OwnerType:    blder.apiType,
-			IsController: true,
+			OwnerType:    blder.forInput.object,
+			IsController: own.resolveIsController(),
 		}
-		if err := blder.ctrl.Watch(src, hdler, blder.predicates...); err != nil {
+		allPredicates := append([]predicate.Predicate(nil), blder.predicates...)
+		allPredicates = append(allPredicates, own.predicates...)
+		if err := blder.ctrl.Watch(src, hdler, allPredicates...); err != nil {
 			return err
 		}
 	}
 
 	// Do the watch requests
-	for _, w := range blder.watchRequest {
-		if err := blder.ctrl.Watch(w.src, w.eventhandler, blder.predicates...); err != nil {
+	for _, w := range blder.watchesInput {
+		src := w.src
+		if kindSrc, ok := w.src.(*source.Kind); ok {
+			typeForSrc, err := blder.project(kindSrc.Type, w.objectProjection)
+			if err != nil {
+				return err
+			}
+			src = &source.Kind{Type: typeForSrc}
+		}
+		allPredicates := append([]predicate.Predicate(nil), blder.predicates...)
+		allPredicates = append(allPredicates, w.predicates...)
+		if err := blder.ctrl.Watch(src, w.eventhandler, allPredicates...); err != nil {
 			return err
 		}
-
 	}
 	return nil
 }
@@ -225,7 +448,10 @@ func (blder *Builder) doManager() error {
 }
 
 func (blder *Builder) getControllerName() (string, error) {
-	gvk, err := getGvk(blder.apiType, blder.mgr.GetScheme())
+	if blder.name != "" {
+		return blder.name, nil
+	}
+	gvk, err := getGvk(blder.forInput.object, blder.mgr.GetScheme())
 	if err != nil {
 		return "", err
 	}
@@ -238,20 +464,19 @@ func (blder *Builder) doController(r reconcile.Reconciler) error {
 	if err != nil {
 		return err
 	}
-	blder.ctrl, err = newController(name, blder.mgr, controller.Options{Reconciler: r})
+	ctrlOptions := blder.ctrlOptions
+	ctrlOptions.Reconciler = r
+	blder.ctrl, err = newController(name, blder.mgr, ctrlOptions)
 	return err
 }
 
 func (blder *Builder) doWebhook() error {
 	// Create a webhook for each type
-	gvk, err := apiutil.GVKForObject(blder.apiType, blder.mgr.GetScheme())
+	gvk, err := apiutil.GVKForObject(blder.forInput.object, blder.mgr.GetScheme())
 	if err != nil {
 		return err
 	}
 
-	partialPath := strings.Replace(gvk.Group, ".", "-", -1) + "-" +
-		gvk.Version + "-" + strings.ToLower(gvk.Kind)
-
 	// TODO: When the conversion webhook lands, we need to handle all registered versions of a given group-kind.
 	// A potential workflow for defaulting webhook
 	// 1) a bespoke (non-hub) version comes in
@@ -264,10 +489,10 @@ func (blder *Builder) doWebhook() error {
 	// 1) a bespoke (non-hub) version comes in
 	// 2) convert it to the hub version
 	// 3) do validation
-	if defaulter, isDefaulter := blder.apiType.(admission.Defaulter); isDefaulter {
+	if defaulter, isDefaulter := blder.forInput.object.(admission.Defaulter); isDefaulter {
 		mwh := admission.DefaultingWebhookFor(defaulter)
 		if mwh != nil {
-			path := "/mutate-" + partialPath
+			path := mutatePath(gvk)
 			log.Info("Registering a mutating webhook",
 				"GVK", gvk,
 				"path", path)
@@ -276,10 +501,10 @@ func (blder *Builder) doWebhook() error {
 		}
 	}
 
-	if validator, isValidator := blder.apiType.(admission.Validator); isValidator {
+	if validator, isValidator := blder.forInput.object.(admission.Validator); isValidator {
 		vwh := admission.ValidatingWebhookFor(validator)
 		if vwh != nil {
-			path := "/validate-" + partialPath
+			path := validatePath(gvk)
 			log.Info("Registering a validating webhook",
 				"GVK", gvk,
 				"path", path)
@@ -0,0 +1,145 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func boolPtr(b bool) *bool { return &b }
+
+// multiOwnerPod returns a Pod owned by two ReplicaSets, the first of which is
+// marked as its controller, mirroring the kind of fixture a garbage-collected
+// ReplicaSet/Pod relationship can produce.
+func multiOwnerPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-1",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs-controller", Controller: boolPtr(true)},
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs-other", Controller: boolPtr(false)},
+			},
+		},
+	}
+}
+
+// fakeQueue records the items added to it, in lieu of pulling in a fake
+// implementation of workqueue.RateLimitingInterface.
+type fakeQueue struct {
+	workqueue.RateLimitingInterface
+	items []interface{}
+}
+
+func (q *fakeQueue) Add(item interface{}) {
+	q.items = append(q.items, item)
+}
+
+func (q *fakeQueue) AddRateLimited(item interface{}) {
+	q.Add(item)
+}
+
+func (q *fakeQueue) AddAfter(item interface{}, d time.Duration) {
+	q.Add(item)
+}
+
+func enqueuedNames(q *fakeQueue) []types.NamespacedName {
+	var names []types.NamespacedName
+	for _, item := range q.items {
+		req, ok := item.(reconcile.Request)
+		if !ok {
+			continue
+		}
+		names = append(names, req.NamespacedName)
+	}
+	return names
+}
+
+// ownsFor builds the OwnsInput that Builder.Owns(apiType, opts...) would
+// produce, so tests exercise the exact same option-application path doWatch
+// consumes via resolveIsController.
+func ownsFor(opts ...OwnsOption) OwnsInput {
+	var owns OwnsInput
+	for _, opt := range opts {
+		opt.ApplyToOwns(&owns)
+	}
+	return owns
+}
+
+func TestOwnsMatchEveryOwnerEnqueuesAllOwners(t *testing.T) {
+	owns := ownsFor(MatchEveryOwner)
+
+	hdler := &handler.EnqueueRequestForOwner{
+		OwnerType:    &appsv1.ReplicaSet{},
+		IsController: owns.resolveIsController(),
+	}
+
+	q := &fakeQueue{}
+	hdler.Create(event.CreateEvent{Object: multiOwnerPod()}, q)
+
+	names := enqueuedNames(q)
+	if len(names) != 2 {
+		t.Fatalf("expected both owners to be enqueued, got %d: %v", len(names), names)
+	}
+	want := map[types.NamespacedName]bool{
+		{Namespace: "default", Name: "rs-controller"}: true,
+		{Namespace: "default", Name: "rs-other"}:      true,
+	}
+	for _, n := range names {
+		if !want[n] {
+			t.Errorf("unexpected reconcile request for %v", n)
+		}
+	}
+}
+
+func TestOwnsDefaultEnqueuesOnlyControllerOwner(t *testing.T) {
+	owns := ownsFor()
+
+	hdler := &handler.EnqueueRequestForOwner{
+		OwnerType:    &appsv1.ReplicaSet{},
+		IsController: owns.resolveIsController(),
+	}
+
+	q := &fakeQueue{}
+	hdler.Create(event.CreateEvent{Object: multiOwnerPod()}, q)
+
+	names := enqueuedNames(q)
+	if len(names) != 1 {
+		t.Fatalf("expected only the controller owner to be enqueued, got %d: %v", len(names), names)
+	}
+	if want := (types.NamespacedName{Namespace: "default", Name: "rs-controller"}); names[0] != want {
+		t.Errorf("got %v, want %v", names[0], want)
+	}
+}
+
+func TestWithControllerOverridesMatchEveryOwner(t *testing.T) {
+	owns := ownsFor(MatchEveryOwner, WithController(true))
+
+	if !owns.resolveIsController() {
+		t.Fatalf("expected an explicit WithController(true) to win over MatchEveryOwner")
+	}
+}
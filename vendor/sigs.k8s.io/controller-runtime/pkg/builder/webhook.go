@@ -0,0 +1,112 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// mutatePath returns the path a mutating webhook for the given GVK is served on.
+func mutatePath(gvk schema.GroupVersionKind) string {
+	return "/mutate-" + strings.Replace(gvk.Group, ".", "-", -1) + "-" +
+		gvk.Version + "-" + strings.ToLower(gvk.Kind)
+}
+
+// validatePath returns the path a validating webhook for the given GVK is served on.
+func validatePath(gvk schema.GroupVersionKind) string {
+	return "/validate-" + strings.Replace(gvk.Group, ".", "-", -1) + "-" +
+		gvk.Version + "-" + strings.ToLower(gvk.Kind)
+}
+
+// WebhookBuilder builds a Webhook for a single apiType, wiring it up to the
+// Manager's webhook server. Unlike ControllerManagedBy, the defaulting and
+// validating logic is supplied by the caller rather than by the apiType
+// itself, so it can hold dependencies (e.g. a client) that the API type
+// shouldn't need to know about.
+type WebhookBuilder struct {
+	apiType   runtime.Object
+	defaulter admission.CustomDefaulter
+	validator admission.CustomValidator
+	mgr       manager.Manager
+}
+
+// WebhookManagedBy returns a new WebhookBuilder that will be started by the provided Manager.
+func WebhookManagedBy(m manager.Manager) *WebhookBuilder {
+	return &WebhookBuilder{mgr: m}
+}
+
+// For defines the type of Object being fronted by the webhook.
+func (blder *WebhookBuilder) For(apiType runtime.Object) *WebhookBuilder {
+	blder.apiType = apiType
+	return blder
+}
+
+// WithDefaulter wires up a mutating webhook for the type configured by For,
+// backed by the given CustomDefaulter, instead of requiring the apiType
+// itself to implement admission.Defaulter.
+func (blder *WebhookBuilder) WithDefaulter(defaulter admission.CustomDefaulter) *WebhookBuilder {
+	blder.defaulter = defaulter
+	return blder
+}
+
+// WithValidator wires up a validating webhook for the type configured by For,
+// backed by the given CustomValidator, instead of requiring the apiType
+// itself to implement admission.Validator.
+func (blder *WebhookBuilder) WithValidator(validator admission.CustomValidator) *WebhookBuilder {
+	blder.validator = validator
+	return blder
+}
+
+// Complete builds the webhook(s) and registers them with the Manager's webhook server.
+func (blder *WebhookBuilder) Complete() error {
+	if blder.apiType == nil {
+		return fmt.Errorf("For(...) must be called to set the type the webhook is for")
+	}
+	if blder.mgr == nil {
+		return fmt.Errorf("WebhookManagedBy(...) must be called with a non-nil Manager")
+	}
+
+	gvk, err := apiutil.GVKForObject(blder.apiType, blder.mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+
+	if blder.defaulter != nil {
+		path := mutatePath(gvk)
+		log.Info("Registering a mutating webhook",
+			"GVK", gvk,
+			"path", path)
+		blder.mgr.GetWebhookServer().Register(path, admission.WithCustomDefaulter(blder.mgr.GetScheme(), blder.apiType, blder.defaulter))
+	}
+
+	if blder.validator != nil {
+		path := validatePath(gvk)
+		log.Info("Registering a validating webhook",
+			"GVK", gvk,
+			"path", path)
+		blder.mgr.GetWebhookServer().Register(path, admission.WithCustomValidator(blder.mgr.GetScheme(), blder.apiType, blder.validator))
+	}
+
+	return nil
+}